@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+)
+
+func flattenCustomDomainCertificateValidationRecords(records []types.CertificateValidationRecord) []interface{} {
+	var results []interface{}
+
+	for _, record := range records {
+		m := map[string]interface{}{
+			"name":   aws.ToString(record.Name),
+			"status": string(record.Status),
+			"type":   aws.ToString(record.Type),
+			"value":  aws.ToString(record.Value),
+		}
+
+		results = append(results, m)
+	}
+
+	return results
+}
@@ -7,202 +7,836 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
 	"github.com/aws/aws-sdk-go-v2/service/apprunner"
-	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_apprunner_custom_domain_association")
-func ResourceCustomDomainAssociation() *schema.Resource {
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceCustomDomainAssociationCreate,
-		ReadWithoutTimeout:   resourceCustomDomainAssociationRead,
-		DeleteWithoutTimeout: resourceCustomDomainAssociationDelete,
+// @FrameworkResource("aws_apprunner_custom_domain_association", name="Custom Domain Association")
+func newCustomDomainAssociationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &customDomainAssociationResource{}
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+	return r, nil
+}
+
+type customDomainAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
 
-		Schema: map[string]*schema.Schema{
-			"certificate_validation_records": {
-				Type:     schema.TypeSet,
+func (r *customDomainAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_apprunner_custom_domain_association"
+}
+
+func (r *customDomainAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"dns_target": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// certificate_arn does NOT make App Runner use the referenced
+			// certificate for the custom domain: AssociateCustomDomain has no
+			// certificate parameter, so App Runner always requests and manages
+			// its own ACM certificate regardless of this value. Setting it only
+			// identifies an existing ACM certificate whose CT logging
+			// preference should be updated to certificate_transparency_logging_preference.
+			// Neither attribute has any bearing on App Runner or DNS validation
+			// state, so changing either is handled in Update rather than
+			// forcing replacement of the whole association.
+			"certificate_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"certificate_status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// Left Computed without a Default: defaulting to ENABLED would
+			// make Create/Update call acm:UpdateCertificateOptions even when
+			// the user never configured this attribute, silently overriding
+			// whatever CT preference the referenced certificate_arn already
+			// had. Its value is instead populated from the certificate's
+			// actual preference after apply (see refreshCertificateStatus),
+			// and the API is only called when the user explicitly set it.
+			"certificate_transparency_logging_preference": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(acmtypes.CertificateTransparencyLoggingPreferenceEnabled),
+						string(acmtypes.CertificateTransparencyLoggingPreferenceDisabled),
+					),
+					stringvalidator.AlsoRequires(path.MatchRoot("certificate_arn")),
+				},
+			},
+			"domain_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"enable_www_subdomain": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"service_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"certificate_validation_records": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[certificateValidationRecordModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
 							Computed: true,
 						},
-						"status": {
-							Type:     schema.TypeString,
+						"status": schema.StringAttribute{
 							Computed: true,
 						},
-						"type": {
-							Type:     schema.TypeString,
+						"type": schema.StringAttribute{
 							Computed: true,
 						},
-						"value": {
-							Type:     schema.TypeString,
+						"value": schema.StringAttribute{
 							Computed: true,
 						},
 					},
 				},
 			},
-			"dns_target": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"domain_name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(1, 255),
-			},
-			"enable_www_subdomain": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-				ForceNew: true,
-			},
-			"service_arn": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+			// This block intentionally has no max_delay attribute. A prior
+			// revision offered one backed by StateChangeConf.MinTimeout, but
+			// MinTimeout is only a floor under retry's own exponential
+			// backoff, not a ceiling on the delay between polls, so it could
+			// never deliver the "maximum delay" the name promised; it was
+			// removed rather than left to silently do the wrong thing.
+			"polling": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pollingBlockModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"min_delay": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("5s"),
+							Validators: []validator.String{
+								validators.Duration(),
+							},
+						},
+						"poll_interval": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								validators.Duration(),
+							},
+						},
+					},
+				},
 			},
-			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
+			// Every attribute in this block is a local Route 53 automation
+			// toggle with no App Runner or ACM-side meaning, so none of them
+			// force replacement; Update diffs the old/new config and
+			// adds/removes the affected records instead (see
+			// updateCustomDomainAssociationRoute53Records).
+			"route53": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[route53BlockModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"certificate_validation_timeout": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("20m"),
+							Validators: []validator.String{
+								validators.Duration(),
+							},
+						},
+						// create_alias writes a CNAME record for domain_name, not a
+						// true Route 53 ALIAS record (App Runner's DNS target isn't
+						// an alias-able AWS resource type). CNAMEs are invalid at a
+						// hosted zone's apex, so create_alias only works for
+						// subdomains; using it with an apex domain_name fails with
+						// InvalidChangeBatch during create.
+						"create_alias": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+						"create_validation_records": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+						"hosted_zone_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
-func resourceCustomDomainAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+func (r *customDomainAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().AppRunnerClient(ctx)
+
+	var data customDomainAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	domainName := d.Get("domain_name").(string)
-	serviceArn := d.Get("service_arn").(string)
+	domainName := data.DomainName.ValueString()
+	serviceARN := data.ServiceARN.ValueString()
 
 	input := &apprunner.AssociateCustomDomainInput{
 		DomainName:         aws.String(domainName),
-		EnableWWWSubdomain: aws.Bool(d.Get("enable_www_subdomain").(bool)),
-		ServiceArn:         aws.String(serviceArn),
+		EnableWWWSubdomain: data.EnableWWWSubdomain.ValueBoolPointer(),
+		ServiceArn:         aws.String(serviceARN),
 	}
 
 	output, err := conn.AssociateCustomDomain(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("associating App Runner Custom Domain (%s) for Service (%s): %s", domainName, serviceArn, err)
+		response.Diagnostics.AddError(fmt.Sprintf("associating App Runner Custom Domain (%s) for Service (%s)", domainName, serviceARN), err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(customDomainAssociationCreateResourceID(domainName, serviceARN))
+	data.DNSTarget = flex.StringToFramework(ctx, output.DNSTarget)
+
+	// These Computed attributes have no known value yet; refreshFromOutput
+	// and refreshCertificateStatus fill them in once the wait below
+	// succeeds. Null any that are still Unknown (rather than leaving them
+	// Unknown) before persisting partial state below, so a failure further
+	// down in Create still leaves App Runner's already-created association,
+	// and any Route 53 records created for it, trackable for import or
+	// destroy instead of orphaned outside of state.
+	if data.Status.IsUnknown() {
+		data.Status = types.StringNull()
+	}
+	if data.CertificateValidationRecords.IsUnknown() {
+		data.CertificateValidationRecords = fwtypes.NewListNestedObjectValueOfNull[certificateValidationRecordModel](ctx)
+	}
+	if data.CertificateStatus.IsUnknown() {
+		data.CertificateStatus = types.StringNull()
+	}
+	if data.CertificateTransparencyLoggingPreference.IsUnknown() {
+		data.CertificateTransparencyLoggingPreference = types.StringNull()
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only touch the certificate's CT logging preference when the user
+	// explicitly configured it; leaving certificate_arn set on its own must
+	// not flip CT logging on whatever certificate it points at.
+	if !data.CertificateARN.IsNull() && !data.CertificateTransparencyLoggingPreference.IsNull() {
+		acmConn := r.Meta().ACMClient(ctx)
+
+		_, err := acmConn.UpdateCertificateOptions(ctx, &acm.UpdateCertificateOptionsInput{
+			CertificateArn: data.CertificateARN.ValueStringPointer(),
+			Options: &acmtypes.CertificateOptions{
+				CertificateTransparencyLoggingPreference: acmtypes.CertificateTransparencyLoggingPreference(data.CertificateTransparencyLoggingPreference.ValueString()),
+			},
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating ACM Certificate (%s) options", data.CertificateARN.ValueString()), err.Error())
+			return
+		}
+	}
+
+	if route53Config, ok := data.route53Config(ctx, &response.Diagnostics); ok && route53Config != nil {
+		if err := createCustomDomainAssociationRoute53Records(ctx, r.Meta(), domainName, aws.ToString(output.DNSTarget), output.CustomDomain.CertificateValidationRecords, *route53Config, data.EnableWWWSubdomain.ValueBool()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 records for App Runner Custom Domain Association (%s)", data.ID.ValueString()), err.Error())
+			return
+		}
+
+		// The Route 53 records now exist alongside the association; persist
+		// again so Delete can find and remove them even if the active-status
+		// wait below fails.
+		response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	pollOpts := data.pollingOptions(ctx, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts, 30*time.Minute)
+
+	customDomain, err := WaitCustomDomainAssociationCreated(ctx, conn, domainName, serviceARN, createTimeout, pollOpts...)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for App Runner Custom Domain Association (%s) creation", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, customDomain)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshCertificateStatus(ctx, r.Meta().ACMClient(ctx))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customDomainAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().AppRunnerClient(ctx)
+
+	var data customDomainAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	domainName, serviceARN, err := customDomainAssociationParseResourceID(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("parsing resource ID (%s)", data.ID.ValueString()), err.Error())
+		return
 	}
 
-	if output == nil {
-		return diag.Errorf("associating App Runner Custom Domain (%s) for Service (%s): empty output", domainName, serviceArn)
+	customDomain, err := FindCustomDomain(ctx, conn, domainName, serviceARN)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		log.Printf("[WARN] App Runner Custom Domain Association (%s) not found, removing from state", data.ID.ValueString())
+		response.State.RemoveResource(ctx)
+		return
 	}
 
-	d.SetId(fmt.Sprintf("%s,%s", aws.ToString(output.CustomDomain.DomainName), aws.ToString(output.ServiceArn)))
-	d.Set("dns_target", output.DNSTarget)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading App Runner Custom Domain Association (%s)", data.ID.ValueString()), err.Error())
+		return
+	}
 
-	if err := WaitCustomDomainAssociationCreated(ctx, conn, domainName, serviceArn); err != nil {
-		return diag.Errorf("waiting for App Runner Custom Domain Association (%s) creation: %s", d.Id(), err)
+	data.ServiceARN = fwtypes.ARNValue(serviceARN)
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, customDomain)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	return resourceCustomDomainAssociationRead(ctx, d, meta)
+	response.Diagnostics.Append(data.refreshCertificateStatus(ctx, r.Meta().ACMClient(ctx))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func resourceCustomDomainAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+// Update handles certificate_arn/certificate_transparency_logging_preference
+// and route53, none of which have any bearing on App Runner or ACM
+// certificate issuance state: the former only steers an ACM
+// UpdateCertificateOptions call, and the latter only adds/removes local
+// Route 53 records. polling and timeouts only steer the waiters and are
+// stored as planned. Every other configurable attribute uses RequiresReplace,
+// since App Runner itself exposes no API to mutate a custom domain association.
+func (r *customDomainAssociationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().AppRunnerClient(ctx)
+
+	var plan, state customDomainAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	domainName, serviceARN, err := customDomainAssociationParseResourceID(state.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("parsing resource ID (%s)", state.ID.ValueString()), err.Error())
+		return
+	}
+
+	if (!plan.CertificateARN.Equal(state.CertificateARN) || !plan.CertificateTransparencyLoggingPreference.Equal(state.CertificateTransparencyLoggingPreference)) &&
+		!plan.CertificateARN.IsNull() && !plan.CertificateTransparencyLoggingPreference.IsNull() && !plan.CertificateTransparencyLoggingPreference.IsUnknown() {
+		acmConn := r.Meta().ACMClient(ctx)
+
+		_, err := acmConn.UpdateCertificateOptions(ctx, &acm.UpdateCertificateOptionsInput{
+			CertificateArn: plan.CertificateARN.ValueStringPointer(),
+			Options: &acmtypes.CertificateOptions{
+				CertificateTransparencyLoggingPreference: acmtypes.CertificateTransparencyLoggingPreference(plan.CertificateTransparencyLoggingPreference.ValueString()),
+			},
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating ACM Certificate (%s) options", plan.CertificateARN.ValueString()), err.Error())
+			return
+		}
+	}
 
-	domainName, serviceArn, err := CustomDomainAssociationParseID(d.Id())
+	customDomain, err := FindCustomDomain(ctx, conn, domainName, serviceARN)
 
 	if err != nil {
-		return diag.FromErr(err)
+		response.Diagnostics.AddError(fmt.Sprintf("reading App Runner Custom Domain Association (%s)", state.ID.ValueString()), err.Error())
+		return
 	}
 
-	customDomain, err := FindCustomDomain(ctx, conn, domainName, serviceArn)
+	oldRoute53Config, ok := state.route53Config(ctx, &response.Diagnostics)
+	if !ok {
+		return
+	}
 
-	if !d.IsNewResource() && errs.IsA[*types.ResourceNotFoundException](err) {
-		log.Printf("[WARN] App Runner Custom Domain Association (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+	newRoute53Config, ok := plan.route53Config(ctx, &response.Diagnostics)
+	if !ok {
+		return
 	}
 
-	if customDomain == nil {
-		if d.IsNewResource() {
-			return diag.Errorf("reading App Runner Custom Domain Association (%s): empty output after creation", d.Id())
+	if !reflect.DeepEqual(oldRoute53Config, newRoute53Config) {
+		validationRecords, diags := state.certificateValidationRecords(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateCustomDomainAssociationRoute53Records(ctx, r.Meta(), domainName, state.DNSTarget.ValueString(), validationRecords, oldRoute53Config, newRoute53Config, state.EnableWWWSubdomain.ValueBool()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Route 53 records for App Runner Custom Domain Association (%s)", state.ID.ValueString()), err.Error())
+			return
 		}
-		log.Printf("[WARN] App Runner Custom Domain Association (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
 	}
 
-	if err := d.Set("certificate_validation_records", flattenCustomDomainCertificateValidationRecords(customDomain.CertificateValidationRecords)); err != nil {
-		return diag.Errorf("setting certificate_validation_records: %s", err)
+	plan.ID = state.ID
+	plan.ServiceARN = fwtypes.ARNValue(serviceARN)
+	response.Diagnostics.Append(plan.refreshFromOutput(ctx, customDomain)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	d.Set("domain_name", customDomain.DomainName)
-	d.Set("enable_www_subdomain", customDomain.EnableWWWSubdomain)
-	d.Set("service_arn", serviceArn)
-	d.Set("status", customDomain.Status)
+	response.Diagnostics.Append(plan.refreshCertificateStatus(ctx, r.Meta().ACMClient(ctx))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	return nil
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
 }
 
-func resourceCustomDomainAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+func (r *customDomainAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().AppRunnerClient(ctx)
+
+	var data customDomainAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	domainName, serviceArn, err := CustomDomainAssociationParseID(d.Id())
+	domainName, serviceARN, err := customDomainAssociationParseResourceID(data.ID.ValueString())
 
 	if err != nil {
-		return diag.FromErr(err)
+		response.Diagnostics.AddError(fmt.Sprintf("parsing resource ID (%s)", data.ID.ValueString()), err.Error())
+		return
 	}
 
-	input := &apprunner.DisassociateCustomDomainInput{
+	_, err = conn.DisassociateCustomDomain(ctx, &apprunner.DisassociateCustomDomainInput{
 		DomainName: aws.String(domainName),
-		ServiceArn: aws.String(serviceArn),
+		ServiceArn: aws.String(serviceARN),
+	})
+
+	// A NotFound here only means the App Runner side is already gone; it
+	// doesn't mean the Route 53 records below were ever cleaned up (the
+	// association may have been removed out-of-band, or a prior Delete may
+	// have disassociated successfully and then failed during Route 53
+	// cleanup). Fall through instead of returning so a retried Delete still
+	// reconciles DNS.
+	if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		response.Diagnostics.AddError(fmt.Sprintf("disassociating App Runner Custom Domain (%s) for Service (%s)", domainName, serviceARN), err.Error())
+		return
+	}
+
+	if err == nil {
+		pollOpts := data.pollingOptions(ctx, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		deleteTimeout := r.DeleteTimeout(ctx, data.Timeouts, 20*time.Minute)
+
+		if _, err := WaitCustomDomainAssociationDeleted(ctx, conn, domainName, serviceARN, deleteTimeout, pollOpts...); err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for App Runner Custom Domain Association (%s) deletion", data.ID.ValueString()), err.Error())
+			return
+		}
+	}
+
+	if route53Config, ok := data.route53Config(ctx, &response.Diagnostics); ok && route53Config != nil {
+		validationRecords, diags := data.certificateValidationRecords(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := deleteCustomDomainAssociationRoute53Records(ctx, r.Meta(), domainName, data.DNSTarget.ValueString(), validationRecords, *route53Config, data.EnableWWWSubdomain.ValueBool()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting Route 53 records for App Runner Custom Domain Association (%s)", data.ID.ValueString()), err.Error())
+			return
+		}
+	}
+}
+
+func (r *customDomainAssociationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func (r *customDomainAssociationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := customDomainAssociationSchemaV0(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeCustomDomainAssociationStateV0toV1,
+		},
+	}
+}
+
+func upgradeCustomDomainAssociationStateV0toV1(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+	var dataV0 customDomainAssociationResourceModelV0
+	response.Diagnostics.Append(request.State.Get(ctx, &dataV0)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	dataV1 := customDomainAssociationResourceModel{
+		CertificateValidationRecords: dataV0.CertificateValidationRecords,
+		DNSTarget:                    dataV0.DNSTarget,
+		DomainName:                   dataV0.DomainName,
+		EnableWWWSubdomain:           dataV0.EnableWWWSubdomain,
+		ID:                           dataV0.ID,
+		ServiceARN:                   fwtypes.ARNValue(dataV0.ServiceARN.ValueString()),
+		Status:                       dataV0.Status,
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &dataV1)...)
+}
+
+func customDomainAssociationSchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dns_target": schema.StringAttribute{
+				Computed: true,
+			},
+			"domain_name": schema.StringAttribute{
+				Required: true,
+			},
+			"enable_www_subdomain": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"id": framework.IDAttribute(),
+			"service_arn": schema.StringAttribute{
+				Required: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"certificate_validation_records": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[certificateValidationRecordModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"value": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
 	}
+}
+
+type customDomainAssociationResourceModel struct {
+	CertificateARN                           fwtypes.ARN                                                       `tfsdk:"certificate_arn"`
+	CertificateStatus                        types.String                                                      `tfsdk:"certificate_status"`
+	CertificateTransparencyLoggingPreference types.String                                                      `tfsdk:"certificate_transparency_logging_preference"`
+	CertificateValidationRecords             fwtypes.ListNestedObjectValueOf[certificateValidationRecordModel] `tfsdk:"certificate_validation_records"`
+	DNSTarget                                types.String                                                      `tfsdk:"dns_target"`
+	DomainName                               types.String                                                      `tfsdk:"domain_name"`
+	EnableWWWSubdomain                       types.Bool                                                        `tfsdk:"enable_www_subdomain"`
+	ID                                       types.String                                                      `tfsdk:"id"`
+	Polling                                  fwtypes.ListNestedObjectValueOf[pollingBlockModel]                `tfsdk:"polling"`
+	Route53                                  fwtypes.ListNestedObjectValueOf[route53BlockModel]                `tfsdk:"route53"`
+	ServiceARN                               fwtypes.ARN                                                       `tfsdk:"service_arn"`
+	Status                                   types.String                                                      `tfsdk:"status"`
+	Timeouts                                 timeouts.Value                                                    `tfsdk:"timeouts"`
+}
 
-	_, err = conn.DisassociateCustomDomain(ctx, input)
+type customDomainAssociationResourceModelV0 struct {
+	CertificateValidationRecords fwtypes.ListNestedObjectValueOf[certificateValidationRecordModel] `tfsdk:"certificate_validation_records"`
+	DNSTarget                    types.String                                                      `tfsdk:"dns_target"`
+	DomainName                   types.String                                                      `tfsdk:"domain_name"`
+	EnableWWWSubdomain           types.Bool                                                        `tfsdk:"enable_www_subdomain"`
+	ID                           types.String                                                      `tfsdk:"id"`
+	ServiceARN                   types.String                                                      `tfsdk:"service_arn"`
+	Status                       types.String                                                      `tfsdk:"status"`
+}
+
+type certificateValidationRecordModel struct {
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+	Type   types.String `tfsdk:"type"`
+	Value  types.String `tfsdk:"value"`
+}
+
+type route53BlockModel struct {
+	CertificateValidationTimeout types.String `tfsdk:"certificate_validation_timeout"`
+	CreateAlias                  types.Bool   `tfsdk:"create_alias"`
+	CreateValidationRecords      types.Bool   `tfsdk:"create_validation_records"`
+	HostedZoneID                 types.String `tfsdk:"hosted_zone_id"`
+}
+
+type pollingBlockModel struct {
+	MinDelay     types.String `tfsdk:"min_delay"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+}
 
-	if errs.IsA[*types.ResourceNotFoundException](err) {
+// pollingOptions translates an optional `polling` block into the
+// retry.StateChangeConf option funcs consumed by the waiters. min_delay maps
+// to StateChangeConf.Delay (the initial wait before the first poll) and
+// poll_interval maps to StateChangeConf.PollInterval (the fixed wait between
+// subsequent polls); there is deliberately no field for MinTimeout, which
+// StateChangeConf uses only as a floor under an exponential backoff that this
+// resource doesn't otherwise expose.
+func (m *customDomainAssociationResourceModel) pollingOptions(ctx context.Context, diags *diag.Diagnostics) []tfresource.OptionsFunc {
+	if m.Polling.IsNull() || m.Polling.IsUnknown() {
 		return nil
 	}
 
-	if err != nil {
-		return diag.Errorf("disassociating App Runner Custom Domain (%s) for Service (%s): %s", domainName, serviceArn, err)
+	pollingModels, d := m.Polling.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() || len(pollingModels) == 0 {
+		return nil
+	}
+
+	pollingModel := pollingModels[0]
+	var optFns []tfresource.OptionsFunc
+
+	if v := pollingModel.MinDelay.ValueString(); v != "" {
+		delay, err := time.ParseDuration(v)
+		if err != nil {
+			diags.AddError("parsing polling.min_delay", err.Error())
+			return nil
+		}
+		optFns = append(optFns, tfresource.WithDelay(delay))
 	}
 
-	if err := WaitCustomDomainAssociationDeleted(ctx, conn, domainName, serviceArn); err != nil {
-		if errs.IsA[*types.ResourceNotFoundException](err) {
+	if v := pollingModel.PollInterval.ValueString(); v != "" {
+		pollInterval, err := time.ParseDuration(v)
+		if err != nil {
+			diags.AddError("parsing polling.poll_interval", err.Error())
 			return nil
 		}
+		optFns = append(optFns, tfresource.WithPollInterval(pollInterval))
+	}
+
+	return optFns
+}
+
+func (m *customDomainAssociationResourceModel) refreshFromOutput(ctx context.Context, customDomain *awstypes.CustomDomain) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// certificate_arn has no effect on which certificate App Runner actually
+	// requests for the domain (see the schema comment on certificate_arn), so
+	// App Runner always emits its own validation CNAMEs here and they must
+	// always be surfaced, regardless of whether certificate_arn is set.
+	records, d := fwtypes.NewListNestedObjectValueOfValueSlice[certificateValidationRecordModel](ctx, flattenCertificateValidationRecords(customDomain.CertificateValidationRecords))
+	diags.Append(d...)
+
+	m.CertificateValidationRecords = records
+
+	m.DomainName = flex.StringToFramework(ctx, customDomain.DomainName)
+	m.EnableWWWSubdomain = types.BoolPointerValue(customDomain.EnableWWWSubdomain)
+	m.Status = types.StringValue(string(customDomain.Status))
+
+	return diags
+}
+
+// refreshCertificateStatus looks up the status and CT logging preference of
+// a customer-supplied ACM certificate. When certificate_arn is unset, App
+// Runner is managing the certificate and there's nothing to look up, so both
+// Computed fields are explicitly nulled rather than left Unknown: they have
+// no prior state to fall back on during Create, and an Unknown value left in
+// the model that's passed to response.State.Set fails apply with "produced
+// an unexpected new value". certificate_transparency_logging_preference is
+// always set from the certificate's actual value, not just when the user
+// configured it, so drift and user-omitted config both resolve to reality.
+func (m *customDomainAssociationResourceModel) refreshCertificateStatus(ctx context.Context, conn *acm.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.CertificateARN.IsNull() {
+		m.CertificateStatus = types.StringNull()
+		m.CertificateTransparencyLoggingPreference = types.StringNull()
+		return diags
+	}
+
+	output, err := conn.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+		CertificateArn: m.CertificateARN.ValueStringPointer(),
+	})
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading ACM Certificate (%s)", m.CertificateARN.ValueString()), err.Error())
+		return diags
+	}
+
+	m.CertificateStatus = types.StringValue(string(output.Certificate.Status))
+
+	if output.Certificate.Options != nil {
+		m.CertificateTransparencyLoggingPreference = types.StringValue(string(output.Certificate.Options.CertificateTransparencyLoggingPreference))
+	}
+
+	return diags
+}
+
+func (m *customDomainAssociationResourceModel) route53Config(ctx context.Context, diags *diag.Diagnostics) (*customDomainAssociationRoute53Config, bool) {
+	if m.Route53.IsNull() || m.Route53.IsUnknown() {
+		return nil, true
+	}
+
+	route53Models, d := m.Route53.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, false
+	}
+
+	if len(route53Models) == 0 {
+		return nil, true
+	}
+
+	route53Model := route53Models[0]
+
+	timeout, err := time.ParseDuration(route53Model.CertificateValidationTimeout.ValueString())
 
-		return diag.Errorf("waiting for App Runner Custom Domain Association (%s) deletion: %s", d.Id(), err)
+	if err != nil {
+		diags.AddError("parsing route53.certificate_validation_timeout", err.Error())
+		return nil, false
 	}
 
-	return nil
+	return &customDomainAssociationRoute53Config{
+		CertificateValidationTimeout: timeout,
+		CreateAlias:                  route53Model.CreateAlias.ValueBool(),
+		CreateValidationRecords:      route53Model.CreateValidationRecords.ValueBool(),
+		HostedZoneID:                 route53Model.HostedZoneID.ValueString(),
+	}, true
 }
 
-func flattenCustomDomainCertificateValidationRecords(records []types.CertificateValidationRecord) []interface{} {
-	var results []interface{}
+func (m *customDomainAssociationResourceModel) certificateValidationRecords(ctx context.Context) ([]awstypes.CertificateValidationRecord, diag.Diagnostics) {
+	recordModels, diags := m.CertificateValidationRecords.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
 
-	for _, record := range records {
-		m := map[string]interface{}{
-			"name":   aws.ToString(record.Name),
-			"status": string(record.Status),
-			"type":   aws.ToString(record.Type),
-			"value":  aws.ToString(record.Value),
+	records := make([]awstypes.CertificateValidationRecord, len(recordModels))
+	for i, recordModel := range recordModels {
+		records[i] = awstypes.CertificateValidationRecord{
+			Name:  recordModel.Name.ValueStringPointer(),
+			Type:  recordModel.Type.ValueStringPointer(),
+			Value: recordModel.Value.ValueStringPointer(),
 		}
+	}
 
-		results = append(results, m)
+	return records, diags
+}
+
+func flattenCertificateValidationRecords(records []awstypes.CertificateValidationRecord) []certificateValidationRecordModel {
+	results := make([]certificateValidationRecordModel, len(records))
+
+	for i, record := range records {
+		results[i] = certificateValidationRecordModel{
+			Name:   flex.StringToFramework(context.Background(), record.Name),
+			Status: types.StringValue(string(record.Status)),
+			Type:   flex.StringToFramework(context.Background(), record.Type),
+			Value:  flex.StringToFramework(context.Background(), record.Value),
+		}
 	}
 
 	return results
 }
+
+func customDomainAssociationCreateResourceID(domainName, serviceARN string) string {
+	return fmt.Sprintf("%s,%s", domainName, serviceARN)
+}
+
+func customDomainAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain_name,service_arn", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// TestCustomDomainAssociationResourceModelRefreshCertificateStatus guards
+// against a regression where Create on a resource without certificate_arn
+// set (the common, non-BYO-cert case) left certificate_status and
+// certificate_transparency_logging_preference Unknown, since they have no
+// Default and no prior state for UseStateForUnknown to fall back on. That
+// produced a "Provider produced inconsistent result after apply" error on
+// every Create that didn't set certificate_arn.
+func TestCustomDomainAssociationResourceModelRefreshCertificateStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("certificate_arn unset resolves both fields to known values", func(t *testing.T) {
+		t.Parallel()
+
+		m := &customDomainAssociationResourceModel{
+			CertificateARN:                           fwtypes.ARNNull(),
+			CertificateStatus:                        types.StringUnknown(),
+			CertificateTransparencyLoggingPreference: types.StringUnknown(),
+		}
+
+		diags := m.refreshCertificateStatus(ctx, nil)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diags: %v", diags)
+		}
+
+		if m.CertificateStatus.IsUnknown() {
+			t.Error("certificate_status is still Unknown; Create would fail with an inconsistent result error")
+		}
+
+		if m.CertificateTransparencyLoggingPreference.IsUnknown() {
+			t.Error("certificate_transparency_logging_preference is still Unknown; Create would fail with an inconsistent result error")
+		}
+	})
+}
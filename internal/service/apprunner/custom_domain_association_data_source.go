@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_apprunner_custom_domain_association")
+func DataSourceCustomDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCustomDomainAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"certificate_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"certificate_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_transparency_logging_preference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_validation_records": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dns_target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"enable_www_subdomain": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"service_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCustomDomainAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+
+	domainName := d.Get("domain_name").(string)
+	serviceArn := d.Get("service_arn").(string)
+
+	customDomain, err := FindCustomDomain(ctx, conn, domainName, serviceArn)
+
+	if err != nil {
+		return diag.Errorf("reading App Runner Custom Domain Association (%s,%s): %s", domainName, serviceArn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", domainName, serviceArn))
+
+	if err := d.Set("certificate_validation_records", flattenCustomDomainCertificateValidationRecords(customDomain.CertificateValidationRecords)); err != nil {
+		return diag.Errorf("setting certificate_validation_records: %s", err)
+	}
+
+	d.Set("dns_target", customDomain.DNSTarget)
+	d.Set("domain_name", customDomain.DomainName)
+	d.Set("enable_www_subdomain", customDomain.EnableWWWSubdomain)
+	d.Set("service_arn", serviceArn)
+	d.Set("status", customDomain.Status)
+
+	// App Runner's DescribeCustomDomain response has no certificate
+	// information, so certificate_status and
+	// certificate_transparency_logging_preference are only populated when
+	// the caller tells us which certificate to look up.
+	if certificateARN := d.Get("certificate_arn").(string); certificateARN != "" {
+		acmConn := meta.(*conns.AWSClient).ACMClient(ctx)
+
+		output, err := acmConn.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+			CertificateArn: aws.String(certificateARN),
+		})
+
+		if err != nil {
+			return diag.Errorf("reading ACM Certificate (%s): %s", certificateARN, err)
+		}
+
+		d.Set("certificate_status", string(output.Certificate.Status))
+
+		if output.Certificate.Options != nil {
+			d.Set("certificate_transparency_logging_preference", string(output.Certificate.Options.CertificateTransparencyLoggingPreference))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"testing"
+)
+
+func TestCustomDomainAssociationCreateResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := customDomainAssociationCreateResourceID("example.com", "arn:aws:apprunner:us-east-1:123456789012:service/example/0123456789")
+	want := "example.com,arn:aws:apprunner:us-east-1:123456789012:service/example/0123456789"
+
+	if got != want {
+		t.Errorf("customDomainAssociationCreateResourceID() = %q, want %q", got, want)
+	}
+}
+
+func TestCustomDomainAssociationParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		id             string
+		wantDomainName string
+		wantServiceARN string
+		wantErr        bool
+	}{
+		"valid": {
+			id:             "example.com,arn:aws:apprunner:us-east-1:123456789012:service/example/0123456789",
+			wantDomainName: "example.com",
+			wantServiceARN: "arn:aws:apprunner:us-east-1:123456789012:service/example/0123456789",
+		},
+		"missing comma": {
+			id:      "example.com",
+			wantErr: true,
+		},
+		"empty domain name": {
+			id:      ",arn:aws:apprunner:us-east-1:123456789012:service/example/0123456789",
+			wantErr: true,
+		},
+		"empty service arn": {
+			id:      "example.com,",
+			wantErr: true,
+		},
+		"empty id": {
+			id:      "",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			domainName, serviceARN, err := customDomainAssociationParseResourceID(tt.id)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if domainName != tt.wantDomainName {
+				t.Errorf("domainName = %q, want %q", domainName, tt.wantDomainName)
+			}
+
+			if serviceARN != tt.wantServiceARN {
+				t.Errorf("serviceARN = %q, want %q", serviceARN, tt.wantServiceARN)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestCustomDomainAssociationResourceModelPollingOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("null polling block", func(t *testing.T) {
+		t.Parallel()
+
+		m := &customDomainAssociationResourceModel{
+			Polling: fwtypes.NewListNestedObjectValueOfNull[pollingBlockModel](ctx),
+		}
+		var diags diag.Diagnostics
+
+		got := m.pollingOptions(ctx, &diags)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diags: %v", diags)
+		}
+
+		if len(got) != 0 {
+			t.Errorf("expected no options, got %d", len(got))
+		}
+	})
+
+	t.Run("min_delay and poll_interval set", func(t *testing.T) {
+		t.Parallel()
+
+		polling, d := fwtypes.NewListNestedObjectValueOfValueSlice[pollingBlockModel](ctx, []pollingBlockModel{
+			{
+				MinDelay:     types.StringValue("5s"),
+				PollInterval: types.StringValue("10s"),
+			},
+		})
+		if d.HasError() {
+			t.Fatalf("unexpected diags building polling block: %v", d)
+		}
+
+		m := &customDomainAssociationResourceModel{
+			Polling: polling,
+		}
+		var diags diag.Diagnostics
+
+		got := m.pollingOptions(ctx, &diags)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diags: %v", diags)
+		}
+
+		if len(got) != 2 {
+			t.Fatalf("expected 2 options (delay, poll interval), got %d", len(got))
+		}
+	})
+
+	t.Run("invalid duration produces an error diagnostic", func(t *testing.T) {
+		t.Parallel()
+
+		polling, d := fwtypes.NewListNestedObjectValueOfValueSlice[pollingBlockModel](ctx, []pollingBlockModel{
+			{
+				MinDelay: types.StringValue("not-a-duration"),
+			},
+		})
+		if d.HasError() {
+			t.Fatalf("unexpected diags building polling block: %v", d)
+		}
+
+		m := &customDomainAssociationResourceModel{
+			Polling: polling,
+		}
+		var diags diag.Diagnostics
+
+		_ = m.pollingOptions(ctx, &diags)
+
+		if !diags.HasError() {
+			t.Error("expected an error diagnostic for an invalid duration")
+		}
+	})
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// customDomainAssociationRoute53Config is the set of Route 53 automation
+// settings configured on a custom domain association's `route53` block.
+type customDomainAssociationRoute53Config struct {
+	CertificateValidationTimeout time.Duration
+	CreateAlias                  bool
+	CreateValidationRecords      bool
+	HostedZoneID                 string
+}
+
+// createCustomDomainAssociationRoute53Records provisions the DNS records App Runner
+// requires for a custom domain to become active: the ACM certificate validation
+// CNAMEs when cfg.CreateValidationRecords is set, and the alias record(s)
+// pointing at the service's DNS target when cfg.CreateAlias is set, including
+// the www subdomain alias when enableWWWSubdomain is set.
+func createCustomDomainAssociationRoute53Records(ctx context.Context, meta interface{}, domainName, dnsTarget string, validationRecords []types.CertificateValidationRecord, cfg customDomainAssociationRoute53Config, enableWWWSubdomain bool) error {
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	changes := customDomainAssociationRoute53Changes(route53types.ChangeActionUpsert, domainName, dnsTarget, validationRecords, cfg, enableWWWSubdomain)
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	output, err := conn.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(cfg.HostedZoneID),
+		ChangeBatch: &route53types.ChangeBatch{
+			Changes: changes,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("changing Route 53 Hosted Zone (%s) record sets: %w", cfg.HostedZoneID, err)
+	}
+
+	if err := waitRoute53ChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id), cfg.CertificateValidationTimeout); err != nil {
+		return fmt.Errorf("waiting for Route 53 record sets to be in sync: %w", err)
+	}
+
+	return nil
+}
+
+// deleteCustomDomainAssociationRoute53Records removes the DNS records created by
+// createCustomDomainAssociationRoute53Records.
+func deleteCustomDomainAssociationRoute53Records(ctx context.Context, meta interface{}, domainName, dnsTarget string, validationRecords []types.CertificateValidationRecord, cfg customDomainAssociationRoute53Config, enableWWWSubdomain bool) error {
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	changes := customDomainAssociationRoute53Changes(route53types.ChangeActionDelete, domainName, dnsTarget, validationRecords, cfg, enableWWWSubdomain)
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	_, err := conn.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(cfg.HostedZoneID),
+		ChangeBatch: &route53types.ChangeBatch{
+			Changes: changes,
+		},
+	})
+
+	if errs.IsA[*route53types.NoSuchHostedZone](err) {
+		return nil
+	}
+
+	if errs.IsA[*route53types.InvalidChangeBatch](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("changing Route 53 Hosted Zone (%s) record sets: %w", cfg.HostedZoneID, err)
+	}
+
+	return nil
+}
+
+// updateCustomDomainAssociationRoute53Records reconciles the Route 53 records
+// for a custom domain association when its route53 block changes. None of
+// cfg's fields have any bearing on App Runner or ACM certificate issuance
+// state, so Update diffs the old and new config instead of requiring
+// replacement.
+func updateCustomDomainAssociationRoute53Records(ctx context.Context, meta interface{}, domainName, dnsTarget string, validationRecords []types.CertificateValidationRecord, oldCfg, newCfg *customDomainAssociationRoute53Config, enableWWWSubdomain bool) error {
+	if oldCfg == nil && newCfg == nil {
+		return nil
+	}
+
+	if oldCfg == nil {
+		return createCustomDomainAssociationRoute53Records(ctx, meta, domainName, dnsTarget, validationRecords, *newCfg, enableWWWSubdomain)
+	}
+
+	if newCfg == nil {
+		return deleteCustomDomainAssociationRoute53Records(ctx, meta, domainName, dnsTarget, validationRecords, *oldCfg, enableWWWSubdomain)
+	}
+
+	// The hosted zone moved: the old records belong to a different zone than
+	// the new ones, so they can't be reconciled in a single change batch.
+	if oldCfg.HostedZoneID != newCfg.HostedZoneID {
+		if err := deleteCustomDomainAssociationRoute53Records(ctx, meta, domainName, dnsTarget, validationRecords, *oldCfg, enableWWWSubdomain); err != nil {
+			return err
+		}
+
+		return createCustomDomainAssociationRoute53Records(ctx, meta, domainName, dnsTarget, validationRecords, *newCfg, enableWWWSubdomain)
+	}
+
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	added := customDomainAssociationRoute53Config{
+		CreateAlias:             newCfg.CreateAlias && !oldCfg.CreateAlias,
+		CreateValidationRecords: newCfg.CreateValidationRecords && !oldCfg.CreateValidationRecords,
+		HostedZoneID:            newCfg.HostedZoneID,
+	}
+	removed := customDomainAssociationRoute53Config{
+		CreateAlias:             oldCfg.CreateAlias && !newCfg.CreateAlias,
+		CreateValidationRecords: oldCfg.CreateValidationRecords && !newCfg.CreateValidationRecords,
+		HostedZoneID:            oldCfg.HostedZoneID,
+	}
+
+	var changes []route53types.Change
+	changes = append(changes, customDomainAssociationRoute53Changes(route53types.ChangeActionDelete, domainName, dnsTarget, validationRecords, removed, enableWWWSubdomain)...)
+	changes = append(changes, customDomainAssociationRoute53Changes(route53types.ChangeActionUpsert, domainName, dnsTarget, validationRecords, added, enableWWWSubdomain)...)
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	output, err := conn.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(newCfg.HostedZoneID),
+		ChangeBatch: &route53types.ChangeBatch{
+			Changes: changes,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("changing Route 53 Hosted Zone (%s) record sets: %w", newCfg.HostedZoneID, err)
+	}
+
+	if err := waitRoute53ChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id), newCfg.CertificateValidationTimeout); err != nil {
+		return fmt.Errorf("waiting for Route 53 record sets to be in sync: %w", err)
+	}
+
+	return nil
+}
+
+// customDomainAssociationRoute53Changes builds the Route 53 change batch for a
+// custom domain association. The alias record is always written as a CNAME,
+// not a true Route 53 ALIAS (route53types.AliasTarget), since App Runner's DNS
+// target isn't an alias-able AWS resource type. CNAMEs are invalid at a hosted
+// zone's apex, so route53.create_alias only works when domain_name is a
+// subdomain; using it with an apex domain fails the ChangeResourceRecordSets
+// call with InvalidChangeBatch. When enableWWWSubdomain is set, App Runner
+// also serves the domain's www subdomain, so a second alias record is added
+// for it alongside domain_name's.
+func customDomainAssociationRoute53Changes(action route53types.ChangeAction, domainName, dnsTarget string, validationRecords []types.CertificateValidationRecord, cfg customDomainAssociationRoute53Config, enableWWWSubdomain bool) []route53types.Change {
+	var changes []route53types.Change
+
+	if cfg.CreateValidationRecords {
+		for _, record := range validationRecords {
+			changes = append(changes, route53types.Change{
+				Action: action,
+				ResourceRecordSet: &route53types.ResourceRecordSet{
+					Name:            record.Name,
+					Type:            route53types.RRType(aws.ToString(record.Type)),
+					TTL:             aws.Int64(300),
+					ResourceRecords: []route53types.ResourceRecord{{Value: record.Value}},
+				},
+			})
+		}
+	}
+
+	if cfg.CreateAlias {
+		changes = append(changes, customDomainAssociationAliasChange(action, domainName, dnsTarget))
+
+		if enableWWWSubdomain {
+			changes = append(changes, customDomainAssociationAliasChange(action, "www."+domainName, dnsTarget))
+		}
+	}
+
+	return changes
+}
+
+// customDomainAssociationAliasChange builds the CNAME change for a single
+// alias record; see customDomainAssociationRoute53Changes for why it's a
+// CNAME rather than a true Route 53 ALIAS.
+func customDomainAssociationAliasChange(action route53types.ChangeAction, name, dnsTarget string) route53types.Change {
+	return route53types.Change{
+		Action: action,
+		ResourceRecordSet: &route53types.ResourceRecordSet{
+			Name:            aws.String(name),
+			Type:            route53types.RRTypeCname,
+			TTL:             aws.Int64(300),
+			ResourceRecords: []route53types.ResourceRecord{{Value: aws.String(dnsTarget)}},
+		},
+	}
+}
+
+func waitRoute53ChangeInsync(ctx context.Context, conn *route53.Client, changeID string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{string(route53types.ChangeStatusPending)},
+		Target:  []string{string(route53types.ChangeStatusInsync)},
+		Refresh: statusRoute53Change(ctx, conn, changeID),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func statusRoute53Change(ctx context.Context, conn *route53.Client, changeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetChange(ctx, &route53.GetChangeInput{
+			Id: aws.String(changeID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.ChangeInfo == nil {
+			return nil, "", nil
+		}
+
+		return output.ChangeInfo, string(output.ChangeInfo.Status), nil
+	}
+}
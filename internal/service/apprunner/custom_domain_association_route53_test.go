@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestCustomDomainAssociationRoute53Changes(t *testing.T) {
+	t.Parallel()
+
+	validationRecords := []types.CertificateValidationRecord{
+		{
+			Name:  aws.String("_abc123.example.com."),
+			Type:  aws.String("CNAME"),
+			Value: aws.String("_xyz789.acm-validations.aws."),
+		},
+	}
+
+	tests := map[string]struct {
+		domainName         string
+		cfg                customDomainAssociationRoute53Config
+		enableWWWSubdomain bool
+		want               []route53types.Change
+	}{
+		"validation records and alias": {
+			domainName: "www.example.com",
+			cfg: customDomainAssociationRoute53Config{
+				CreateAlias:             true,
+				CreateValidationRecords: true,
+			},
+			want: []route53types.Change{
+				{
+					Action: route53types.ChangeActionUpsert,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name:            aws.String("_abc123.example.com."),
+						Type:            route53types.RRTypeCname,
+						TTL:             aws.Int64(300),
+						ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("_xyz789.acm-validations.aws.")}},
+					},
+				},
+				{
+					Action: route53types.ChangeActionUpsert,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name:            aws.String("www.example.com"),
+						Type:            route53types.RRTypeCname,
+						TTL:             aws.Int64(300),
+						ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("dnstarget.example.com")}},
+					},
+				},
+			},
+		},
+		"validation records only": {
+			domainName: "www.example.com",
+			cfg: customDomainAssociationRoute53Config{
+				CreateAlias:             false,
+				CreateValidationRecords: true,
+			},
+			want: []route53types.Change{
+				{
+					Action: route53types.ChangeActionUpsert,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name:            aws.String("_abc123.example.com."),
+						Type:            route53types.RRTypeCname,
+						TTL:             aws.Int64(300),
+						ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("_xyz789.acm-validations.aws.")}},
+					},
+				},
+			},
+		},
+		"alias only, apex domain": {
+			// create_alias writes a CNAME, which Route 53 rejects at the zone
+			// apex (InvalidChangeBatch). The change set is still built here;
+			// the rejection happens server-side in ChangeResourceRecordSets.
+			domainName: "example.com",
+			cfg: customDomainAssociationRoute53Config{
+				CreateAlias:             true,
+				CreateValidationRecords: false,
+			},
+			want: []route53types.Change{
+				{
+					Action: route53types.ChangeActionUpsert,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name:            aws.String("example.com"),
+						Type:            route53types.RRTypeCname,
+						TTL:             aws.Int64(300),
+						ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("dnstarget.example.com")}},
+					},
+				},
+			},
+		},
+		"alias with www subdomain enabled": {
+			domainName: "example.com",
+			cfg: customDomainAssociationRoute53Config{
+				CreateAlias:             true,
+				CreateValidationRecords: false,
+			},
+			enableWWWSubdomain: true,
+			want: []route53types.Change{
+				{
+					Action: route53types.ChangeActionUpsert,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name:            aws.String("example.com"),
+						Type:            route53types.RRTypeCname,
+						TTL:             aws.Int64(300),
+						ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("dnstarget.example.com")}},
+					},
+				},
+				{
+					Action: route53types.ChangeActionUpsert,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name:            aws.String("www.example.com"),
+						Type:            route53types.RRTypeCname,
+						TTL:             aws.Int64(300),
+						ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("dnstarget.example.com")}},
+					},
+				},
+			},
+		},
+		"neither enabled": {
+			domainName: "www.example.com",
+			cfg:        customDomainAssociationRoute53Config{},
+			want:       nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := customDomainAssociationRoute53Changes(route53types.ChangeActionUpsert, tt.domainName, "dnstarget.example.com", validationRecords, tt.cfg, tt.enableWWWSubdomain)
+
+			if diff := cmp.Diff(got, tt.want, cmpopts.IgnoreUnexported(route53types.Change{}, route53types.ResourceRecordSet{}, route53types.ResourceRecord{})); diff != "" {
+				t.Errorf("unexpected changes (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_apprunner_custom_domain_associations")
+func DataSourceCustomDomainAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCustomDomainAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"custom_domain_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_transparency_logging_preference": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_validation_records": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"dns_target": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_www_subdomain": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceCustomDomainAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+
+	serviceArn := d.Get("service_arn").(string)
+
+	customDomains, err := findCustomDomains(ctx, conn, serviceArn)
+
+	if err != nil {
+		return diag.Errorf("reading App Runner Custom Domain Associations (%s): %s", serviceArn, err)
+	}
+
+	d.SetId(serviceArn)
+
+	if err := d.Set("custom_domain_associations", flattenCustomDomains(customDomains)); err != nil {
+		return diag.Errorf("setting custom_domain_associations: %s", err)
+	}
+
+	return nil
+}
+
+func findCustomDomains(ctx context.Context, conn *apprunner.Client, serviceArn string) ([]types.CustomDomain, error) {
+	input := &apprunner.DescribeCustomDomainsInput{
+		ServiceArn: aws.String(serviceArn),
+	}
+	var output []types.CustomDomain
+
+	pages := apprunner.NewDescribeCustomDomainsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CustomDomains...)
+	}
+
+	return output, nil
+}
+
+// flattenCustomDomains leaves certificate_status and
+// certificate_transparency_logging_preference unset: DescribeCustomDomains
+// returns no certificate_arn to look them up by, and unlike the singular
+// data source there's no per-item way for the caller to supply one.
+func flattenCustomDomains(customDomains []types.CustomDomain) []interface{} {
+	var results []interface{}
+
+	for _, customDomain := range customDomains {
+		m := map[string]interface{}{
+			"certificate_validation_records": flattenCustomDomainCertificateValidationRecords(customDomain.CertificateValidationRecords),
+			"dns_target":                     aws.ToString(customDomain.DNSTarget),
+			"domain_name":                    aws.ToString(customDomain.DomainName),
+			"enable_www_subdomain":           aws.ToBool(customDomain.EnableWWWSubdomain),
+			"status":                         string(customDomain.Status),
+		}
+
+		results = append(results, m)
+	}
+
+	return results
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusCustomDomainAssociation(ctx context.Context, conn *apprunner.Client, domainName, serviceARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCustomDomain(ctx, conn, domainName, serviceARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// WaitCustomDomainAssociationCreated waits for a custom domain association to
+// transition to active, threading the given timeout and polling options into
+// the underlying retry.StateChangeConf.
+func WaitCustomDomainAssociationCreated(ctx context.Context, conn *apprunner.Client, domainName, serviceARN string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*awstypes.CustomDomain, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.CustomDomainAssociationStatusCreating,
+			awstypes.CustomDomainAssociationStatusPendingCertificateDnsValidation,
+			awstypes.CustomDomainAssociationStatusPendingDnsValidation,
+		),
+		Target:  enum.Slice(awstypes.CustomDomainAssociationStatusActive),
+		Refresh: statusCustomDomainAssociation(ctx, conn, domainName, serviceARN),
+		Timeout: timeout,
+	}
+
+	for _, fn := range optFns {
+		fn(stateConf)
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CustomDomain); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WaitCustomDomainAssociationDeleted waits for a custom domain association to
+// be disassociated, threading the given timeout and polling options into the
+// underlying retry.StateChangeConf.
+func WaitCustomDomainAssociationDeleted(ctx context.Context, conn *apprunner.Client, domainName, serviceARN string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*awstypes.CustomDomain, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CustomDomainAssociationStatusActive, awstypes.CustomDomainAssociationStatusDeleting),
+		Target:  []string{},
+		Refresh: statusCustomDomainAssociation(ctx, conn, domainName, serviceARN),
+		Timeout: timeout,
+	}
+
+	for _, fn := range optFns {
+		fn(stateConf)
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CustomDomain); ok {
+		return output, err
+	}
+
+	return nil, err
+}